@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+func DataSourceIBMEnterpriseHierarchy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEnterpriseHierarchyRead,
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the enterprise to walk the account-group/account tree of.",
+			},
+			"account_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Every account group under the enterprise, each with the CRNs of its direct child accounts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The account group ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the account group.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) of the account group.",
+						},
+						"parent": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the parent account group, or the enterprise CRN when the account group sits directly under the enterprise.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the account group.",
+						},
+						"account_crns": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The CRNs of the accounts that sit directly under this account group.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"unassigned_account_crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CRNs of accounts that sit directly under the enterprise, outside of any account group.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEnterpriseHierarchyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enterpriseID := d.Get("enterprise_id").(string)
+
+	getEnterpriseOptions := &enterprisemanagementv1.GetEnterpriseOptions{
+		EnterpriseID: &enterpriseID,
+	}
+	enterpriseInstance, response, err := enterpriseManagementClient.GetEnterpriseWithContext(context, getEnterpriseOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching enterprise %s: %s\n%s", enterpriseID, err, response))
+	}
+	enterpriseCRN := *enterpriseInstance.CRN
+
+	accountGroups, err := listChildAccountGroups(context, enterpriseManagementClient, enterpriseID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing account groups for enterprise %s: %s", enterpriseID, err))
+	}
+	accounts, err := listChildAccounts(context, enterpriseManagementClient, enterpriseID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing accounts for enterprise %s: %s", enterpriseID, err))
+	}
+
+	accountCRNsByParent := make(map[string][]string)
+	var unassignedAccountCRNs []string
+	for _, account := range accounts {
+		if account.Parent == nil {
+			continue
+		}
+		// Accounts directly under the enterprise are parented by the enterprise's CRN,
+		// not its plain ID, since Parent always holds a CRN.
+		if *account.Parent == enterpriseCRN {
+			unassignedAccountCRNs = append(unassignedAccountCRNs, *account.CRN)
+			continue
+		}
+		accountCRNsByParent[*account.Parent] = append(accountCRNsByParent[*account.Parent], *account.CRN)
+	}
+
+	accountGroupList := make([]map[string]interface{}, 0, len(accountGroups))
+	for _, ag := range accountGroups {
+		accountGroupList = append(accountGroupList, map[string]interface{}{
+			"id":           ag.ID,
+			"name":         ag.Name,
+			"crn":          ag.CRN,
+			"parent":       ag.Parent,
+			"state":        ag.State,
+			"account_crns": accountCRNsByParent[*ag.CRN],
+		})
+	}
+
+	if err = d.Set("account_groups", accountGroupList); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_groups: %s", err))
+	}
+	if err = d.Set("unassigned_account_crns", unassignedAccountCRNs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting unassigned_account_crns: %s", err))
+	}
+
+	d.SetId(enterpriseID)
+
+	return nil
+}
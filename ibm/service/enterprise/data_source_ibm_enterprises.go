@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+func DataSourceIBMEnterprises() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEnterprisesRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the returned enterprises to those with this exact name.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the returned enterprises to those with this domain.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the returned enterprises to those in this state, such as `ACTIVE`.",
+			},
+			"enterprise_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the returned enterprises to the one whose enterprise account has this ID.",
+			},
+			"enterprises": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The enterprises that matched the given filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The enterprise ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the enterprise.",
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The domain of the enterprise.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the enterprise.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the enterprise.",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) of the enterprise.",
+						},
+						"enterprise_account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The enterprise account ID.",
+						},
+						"primary_contact_iam_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the enterprise primary contact.",
+						},
+						"primary_contact_email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email of the primary contact of the enterprise.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEnterprisesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listEnterprisesOptions := &enterprisemanagementv1.ListEnterprisesOptions{}
+	if name, ok := d.GetOk("name"); ok {
+		listEnterprisesOptions.SetName(name.(string))
+	}
+	if domain, ok := d.GetOk("domain"); ok {
+		listEnterprisesOptions.SetDomain(domain.(string))
+	}
+	if enterpriseAccountID, ok := d.GetOk("enterprise_account_id"); ok {
+		listEnterprisesOptions.SetEnterpriseAccountID(enterpriseAccountID.(string))
+	}
+
+	stateFilter, filterByState := d.GetOk("state")
+
+	var matched []enterprisemanagementv1.Enterprise
+	for {
+		enterprisesList, response, err := enterpriseManagementClient.ListEnterprisesWithContext(context, listEnterprisesOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing enterprises: %s\n%s", err, response))
+		}
+
+		for _, enterprise := range enterprisesList.Resources {
+			if filterByState && (enterprise.State == nil || *enterprise.State != stateFilter.(string)) {
+				continue
+			}
+			matched = append(matched, enterprise)
+		}
+
+		if enterprisesList.NextURL == nil || *enterprisesList.NextURL == "" {
+			break
+		}
+		nextDocid, err := core.GetQueryParam(enterprisesList.NextURL, "next_docid")
+		if err != nil || nextDocid == nil {
+			break
+		}
+		listEnterprisesOptions.NextDocid = nextDocid
+	}
+
+	enterprises := make([]map[string]interface{}, 0, len(matched))
+	for _, enterprise := range matched {
+		enterprises = append(enterprises, map[string]interface{}{
+			"id":                     enterprise.ID,
+			"name":                   enterprise.Name,
+			"domain":                 enterprise.Domain,
+			"state":                  enterprise.State,
+			"url":                    enterprise.URL,
+			"crn":                    enterprise.CRN,
+			"enterprise_account_id":  enterprise.EnterpriseAccountID,
+			"primary_contact_iam_id": enterprise.PrimaryContactIamID,
+			"primary_contact_email":  enterprise.PrimaryContactEmail,
+		})
+	}
+
+	if err = d.Set("enterprises", enterprises); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enterprises: %s", err))
+	}
+
+	d.SetId(dataSourceIBMEnterprisesID(d))
+
+	return nil
+}
+
+func dataSourceIBMEnterprisesID(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s-%s-%s-%s", d.Get("name").(string), d.Get("domain").(string), d.Get("state").(string), d.Get("enterprise_account_id").(string))
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
 )
 
@@ -98,12 +100,52 @@ func ResourceIBMEnterprise() *schema.Resource {
 				Computed:    true,
 				Description: "The IAM ID of the user or service that updated the enterprise.",
 			},
+			"endpoint_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A service endpoint to direct this resource's API calls to, such as a private, test, or staging URL. When set, the global provider session is left untouched and only this resource's client is redirected. Defaults to the endpoint configured on the provider.",
+			},
+			"wait_for_state": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to wait for the enterprise to reach the `ACTIVE` state before considering create/update complete. Disable this to return as soon as the API call is accepted, without polling.",
+			},
+			"on_delete": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "retain",
+				Description:  "Determines what happens to any account groups and accounts still under the enterprise when this resource is destroyed. `retain` (the default) leaves them in place and only removes the enterprise from Terraform state, `fail_if_children` aborts the destroy with an error while any child exists, and `move_to_standalone` reports every child that could not be demoted, since the Enterprise Management API does not currently expose an operation to do so.",
+				ValidateFunc: validate.ValidateAllowedStringValue([]string{"retain", "fail_if_children", "move_to_standalone"}),
+			},
 		},
 	}
 }
 
+// enterpriseManagementClientForResource returns the shared EnterpriseManagementV1 client, or,
+// when the resource sets endpoint_url, a Clone() of it redirected with SetServiceURL so that
+// the shared ClientSession's endpoint is left untouched for other resources. Shared by every
+// resource in this package that declares an endpoint_url attribute (currently ibm_enterprise
+// and ibm_enterprise_account_import); ibm_enterprise_account and ibm_enterprise_account_group
+// don't exist in this package yet, so they get this override once they're added.
+func enterpriseManagementClientForResource(meta interface{}, d *schema.ResourceData) (*enterprisemanagementv1.EnterpriseManagementV1, error) {
+	client, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return nil, err
+	}
+
+	if endpointURL, ok := d.GetOk("endpoint_url"); ok && endpointURL.(string) != "" {
+		client = client.Clone()
+		if err := client.SetServiceURL(endpointURL.(string)); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
 func resourceIbmEnterpriseCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	enterpriseManagementClient, err := enterpriseManagementClientForResource(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,11 +162,18 @@ func resourceIbmEnterpriseCreate(context context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	d.SetId(*createEnterpriseResponse.EnterpriseID)
+
+	if d.Get("wait_for_state").(bool) {
+		if _, err := waitForEnterpriseActive(context, enterpriseManagementClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for enterprise (%s) to become active: %s", d.Id(), err))
+		}
+	}
+
 	return resourceIbmEnterpriseRead(context, d, meta)
 }
 
 func resourceIbmEnterpriseRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	enterpriseManagementClient, err := enterpriseManagementClientForResource(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -187,7 +236,7 @@ func resourceIbmEnterpriseRead(context context.Context, d *schema.ResourceData,
 }
 
 func resourceIbmEnterpriseUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	enterpriseManagementClient, err := enterpriseManagementClientForResource(meta, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -222,13 +271,172 @@ func resourceIbmEnterpriseUpdate(context context.Context, d *schema.ResourceData
 			log.Printf("[DEBUG] UpdateEnterpriseWithContext failed %s\n%s", err, response)
 			return diag.FromErr(err)
 		}
+
+		if d.Get("wait_for_state").(bool) {
+			if _, err := waitForEnterpriseActive(context, enterpriseManagementClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error waiting for enterprise (%s) to become active: %s", d.Id(), err))
+			}
+		}
 	}
 
 	return resourceIbmEnterpriseRead(context, d, meta)
 }
 
+// waitForEnterpriseActive polls GetEnterpriseWithContext until the enterprise reaches the
+// ACTIVE state, returns a contextual error on a terminal FAILED/SUSPENDED state, or times out.
+func waitForEnterpriseActive(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, enterpriseID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING", "UPDATING", "PENDING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			getEnterpriseOptions := &enterprisemanagementv1.GetEnterpriseOptions{
+				EnterpriseID: &enterpriseID,
+			}
+			enterprise, response, err := client.GetEnterpriseWithContext(context, getEnterpriseOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return nil, "", nil
+				}
+				return nil, "", err
+			}
+
+			if enterprise.State == nil {
+				// Treat a not-yet-populated state as still pending, the same as any other
+				// intermediate response this loop is built to ride out.
+				return enterprise, "PENDING", nil
+			}
+
+			state := *enterprise.State
+			if state == "FAILED" || state == "SUSPENDED" {
+				return enterprise, state, fmt.Errorf("enterprise %s reached terminal state %q", enterpriseID, state)
+			}
+
+			return enterprise, state, nil
+		},
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+		MinTimeout:   15 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
 func resourceIbmEnterpriseDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := enterpriseManagementClientForResource(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enterpriseID := d.Id()
+
+	accountGroups, err := listChildAccountGroups(context, enterpriseManagementClient, enterpriseID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing account groups for enterprise %s: %s", enterpriseID, err))
+	}
+	accounts, err := listChildAccounts(context, enterpriseManagementClient, enterpriseID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing accounts for enterprise %s: %s", enterpriseID, err))
+	}
+
+	diags := enterpriseDeleteDiagnostics(enterpriseID, d.Get("on_delete").(string), accountGroups, accounts)
+	if diags.HasError() {
+		return diags
+	}
+
 	d.SetId("")
+	return diags
+}
 
-	return nil
+// enterpriseDeleteDiagnostics decides what a destroy should report for an enterprise's
+// remaining account groups and accounts under the given on_delete mode: a rejection for
+// fail_if_children, or a diag.Warning naming every child that retain/move_to_standalone leave
+// behind. It has no network dependency, so the branch logic can be unit tested directly.
+func enterpriseDeleteDiagnostics(enterpriseID, onDelete string, accountGroups []enterprisemanagementv1.AccountGroup, accounts []enterprisemanagementv1.Account) diag.Diagnostics {
+	if len(accountGroups) == 0 && len(accounts) == 0 {
+		return nil
+	}
+
+	if onDelete == "fail_if_children" {
+		return diag.Errorf("enterprise %s still has %d account group(s) and %d account(s); remove them first, or set on_delete to \"retain\" or \"move_to_standalone\"", enterpriseID, len(accountGroups), len(accounts))
+	}
+
+	// The Enterprise Management API does not expose a verb that tears down an enterprise or
+	// demotes its children back to standalone accounts, so even in "move_to_standalone" mode
+	// the best this resource can do is make the resulting orphaning explicit.
+	disposition := "left under the enterprise"
+	if onDelete == "move_to_standalone" {
+		disposition = "NOT moved to standalone, because the Enterprise Management API exposes no such operation, and remains under the enterprise"
+	}
+
+	var diags diag.Diagnostics
+	for _, ag := range accountGroups {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Account group orphaned by terraform destroy",
+			Detail:   fmt.Sprintf("Account group %q (%s) was %s.", *ag.Name, *ag.CRN, disposition),
+		})
+	}
+	for _, account := range accounts {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Account orphaned by terraform destroy",
+			Detail:   fmt.Sprintf("Account %q (%s) was %s.", *account.Name, *account.CRN, disposition),
+		})
+	}
+
+	return diags
+}
+
+// listChildAccountGroups returns every account group directly or indirectly owned by the
+// given enterprise, following the API's next_docid cursor until the list is exhausted.
+func listChildAccountGroups(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, enterpriseID string) ([]enterprisemanagementv1.AccountGroup, error) {
+	var result []enterprisemanagementv1.AccountGroup
+
+	listAccountGroupsOptions := &enterprisemanagementv1.ListAccountGroupsOptions{
+		EnterpriseID: &enterpriseID,
+	}
+	for {
+		accountGroupsList, response, err := client.ListAccountGroupsWithContext(context, listAccountGroupsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("%s\n%s", err, response)
+		}
+		result = append(result, accountGroupsList.Resources...)
+		if accountGroupsList.NextURL == nil || *accountGroupsList.NextURL == "" {
+			break
+		}
+		nextDocid, err := core.GetQueryParam(accountGroupsList.NextURL, "next_docid")
+		if err != nil || nextDocid == nil {
+			break
+		}
+		listAccountGroupsOptions.NextDocid = nextDocid
+	}
+
+	return result, nil
+}
+
+// listChildAccounts returns every account directly or indirectly owned by the given
+// enterprise, following the API's next_docid cursor until the list is exhausted.
+func listChildAccounts(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, enterpriseID string) ([]enterprisemanagementv1.Account, error) {
+	var result []enterprisemanagementv1.Account
+
+	listAccountsOptions := &enterprisemanagementv1.ListAccountsOptions{
+		EnterpriseID: &enterpriseID,
+	}
+	for {
+		accountsList, response, err := client.ListAccountsWithContext(context, listAccountsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("%s\n%s", err, response)
+		}
+		result = append(result, accountsList.Resources...)
+		if accountsList.NextURL == nil || *accountsList.NextURL == "" {
+			break
+		}
+		nextDocid, err := core.GetQueryParam(accountsList.NextURL, "next_docid")
+		if err != nil || nextDocid == nil {
+			break
+		}
+		listAccountsOptions.NextDocid = nextDocid
+	}
+
+	return result, nil
 }
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+func ResourceIBMEnterpriseAccountImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmEnterpriseAccountImportCreate,
+		ReadContext:   resourceIbmEnterpriseAccountImportRead,
+		DeleteContext: resourceIbmEnterpriseAccountImportDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the enterprise to import the account into.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the existing, standalone account to import.",
+			},
+			"parent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of the account group to import the account into. If not specified, the account is imported directly under the enterprise.",
+			},
+			"source_account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the account performing the import. Defaults to the calling identity's account and must match the owning account of `enterprise_id`; the import is rejected otherwise.",
+			},
+			"endpoint_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A service endpoint to direct this resource's API calls to, such as a private, test, or staging URL. When set, the global provider session is left untouched and only this resource's client is redirected. Defaults to the endpoint configured on the provider.",
+			},
+		},
+	}
+}
+
+func resourceIbmEnterpriseAccountImportCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := enterpriseManagementClientForResource(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enterpriseID := d.Get("enterprise_id").(string)
+	accountID := d.Get("account_id").(string)
+
+	getEnterpriseOptions := &enterprisemanagementv1.GetEnterpriseOptions{
+		EnterpriseID: &enterpriseID,
+	}
+	enterpriseInstance, response, err := enterpriseManagementClient.GetEnterpriseWithContext(context, getEnterpriseOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetEnterpriseWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching enterprise %s: %s", enterpriseID, err))
+	}
+
+	sourceAccountID := d.Get("source_account_id").(string)
+	if sourceAccountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		sourceAccountID = userDetails.UserAccount
+	}
+	if enterpriseInstance.EnterpriseAccountID != nil && sourceAccountID != *enterpriseInstance.EnterpriseAccountID {
+		return diag.Errorf("the calling identity's account (%s) does not match the owning account of enterprise %s (%s); only the enterprise owner can import accounts into it", sourceAccountID, enterpriseID, *enterpriseInstance.EnterpriseAccountID)
+	}
+	if err = d.Set("source_account_id", sourceAccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting source_account_id: %s", err))
+	}
+
+	getAccountOptions := &enterprisemanagementv1.GetAccountOptions{
+		AccountID: &accountID,
+	}
+	existingAccount, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error fetching account %s: %s", accountID, err))
+	}
+	if existingAccount.EnterpriseID != nil && *existingAccount.EnterpriseID != "" && *existingAccount.EnterpriseID != enterpriseID {
+		return diag.Errorf("account %s already belongs to enterprise %s and cannot be re-imported into enterprise %s", accountID, *existingAccount.EnterpriseID, enterpriseID)
+	}
+	if existingAccount.EnterpriseID != nil && *existingAccount.EnterpriseID == enterpriseID {
+		d.SetId(accountID)
+		return resourceIbmEnterpriseAccountImportRead(context, d, meta)
+	}
+
+	importAccountToEnterpriseOptions := &enterprisemanagementv1.ImportAccountToEnterpriseOptions{
+		EnterpriseID: &enterpriseID,
+		AccountID:    &accountID,
+	}
+	if parent, ok := d.GetOk("parent"); ok {
+		importAccountToEnterpriseOptions.SetParent(parent.(string))
+	}
+
+	response, err = enterpriseManagementClient.ImportAccountToEnterpriseWithContext(context, importAccountToEnterpriseOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ImportAccountToEnterpriseWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error importing account %s into enterprise %s: %s", accountID, enterpriseID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceIbmEnterpriseAccountImportRead(context, d, meta)
+}
+
+func resourceIbmEnterpriseAccountImportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := enterpriseManagementClientForResource(meta, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Id()
+	getAccountOptions := &enterprisemanagementv1.GetAccountOptions{
+		AccountID: &accountID,
+	}
+	account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	// enterprise_id is still unset right after `terraform import`, since the default
+	// passthrough importer only populates the ID. Only treat a mismatch as drift once
+	// enterprise_id is actually known; otherwise populate it from the account itself.
+	if enterpriseID := d.Get("enterprise_id").(string); enterpriseID != "" && (account.EnterpriseID == nil || *account.EnterpriseID != enterpriseID) {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("account_id", account.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account_id: %s", err))
+	}
+	if err = d.Set("enterprise_id", account.EnterpriseID); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enterprise_id: %s", err))
+	}
+	if err = d.Set("parent", account.Parent); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting parent: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmEnterpriseAccountImportDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The Enterprise Management API does not expose an operation to move an imported
+	// account back to standalone, so destroying this resource only forgets it in
+	// Terraform state; the account remains part of the enterprise.
+	diags := diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Account left in enterprise",
+			Detail:   fmt.Sprintf("Account %s was not moved back to standalone, because the Enterprise Management API exposes no such operation, and remains part of enterprise %s.", d.Id(), d.Get("enterprise_id").(string)),
+		},
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+func testAccountGroup(name, crn string) enterprisemanagementv1.AccountGroup {
+	return enterprisemanagementv1.AccountGroup{Name: &name, CRN: &crn}
+}
+
+func testAccount(name, crn string) enterprisemanagementv1.Account {
+	return enterprisemanagementv1.Account{Name: &name, CRN: &crn}
+}
+
+func TestEnterpriseDeleteDiagnosticsNoChildren(t *testing.T) {
+	diags := enterpriseDeleteDiagnostics("enterprise-id", "retain", nil, nil)
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics when the enterprise has no children, got %d", len(diags))
+	}
+}
+
+func TestEnterpriseDeleteDiagnosticsFailIfChildren(t *testing.T) {
+	accountGroups := []enterprisemanagementv1.AccountGroup{testAccountGroup("group-1", "crn:group-1")}
+	accounts := []enterprisemanagementv1.Account{testAccount("account-1", "crn:account-1")}
+
+	diags := enterpriseDeleteDiagnostics("enterprise-id", "fail_if_children", accountGroups, accounts)
+
+	if !diags.HasError() {
+		t.Fatalf("expected fail_if_children to return an error when children are present, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Summary, "enterprise-id") {
+		t.Fatalf("expected the error to name the enterprise, got %q", diags[0].Summary)
+	}
+}
+
+func TestEnterpriseDeleteDiagnosticsRetain(t *testing.T) {
+	accountGroups := []enterprisemanagementv1.AccountGroup{testAccountGroup("group-1", "crn:group-1")}
+	accounts := []enterprisemanagementv1.Account{testAccount("account-1", "crn:account-1")}
+
+	diags := enterpriseDeleteDiagnostics("enterprise-id", "retain", accountGroups, accounts)
+
+	if diags.HasError() {
+		t.Fatalf("retain must not fail the destroy, got %v", diags)
+	}
+	if len(diags) != 2 {
+		t.Fatalf("expected one warning per orphaned child, got %d", len(diags))
+	}
+	for _, d := range diags {
+		if d.Severity != diag.Warning {
+			t.Fatalf("expected every retain diagnostic to be a warning, got %v", d.Severity)
+		}
+		if !strings.Contains(d.Detail, "left under the enterprise") {
+			t.Fatalf("expected retain to describe children as left under the enterprise, got %q", d.Detail)
+		}
+	}
+}
+
+func TestEnterpriseDeleteDiagnosticsMoveToStandalone(t *testing.T) {
+	accountGroups := []enterprisemanagementv1.AccountGroup{testAccountGroup("group-1", "crn:group-1")}
+	accounts := []enterprisemanagementv1.Account{testAccount("account-1", "crn:account-1")}
+
+	diags := enterpriseDeleteDiagnostics("enterprise-id", "move_to_standalone", accountGroups, accounts)
+
+	if diags.HasError() {
+		t.Fatalf("move_to_standalone must not fail the destroy, got %v", diags)
+	}
+	if len(diags) != 2 {
+		t.Fatalf("expected one warning per orphaned child, got %d", len(diags))
+	}
+	for _, d := range diags {
+		if !strings.Contains(d.Detail, "NOT moved to standalone") {
+			t.Fatalf("expected move_to_standalone to describe the unmet demotion, got %q", d.Detail)
+		}
+	}
+}
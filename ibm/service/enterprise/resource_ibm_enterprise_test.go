@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccCheckIBMEnterprisePreCheck(t *testing.T) {
+	if os.Getenv("IBM_ENTERPRISE_SOURCE_ACCOUNT_ID") == "" {
+		t.Fatal("IBM_ENTERPRISE_SOURCE_ACCOUNT_ID must be set for acceptance tests")
+	}
+	if os.Getenv("IBM_ENTERPRISE_PRIMARY_CONTACT_IAM_ID") == "" {
+		t.Fatal("IBM_ENTERPRISE_PRIMARY_CONTACT_IAM_ID must be set for acceptance tests")
+	}
+}
+
+func TestAccIBMEnterpriseOnDeleteRetain(t *testing.T) {
+	resourceName := "ibm_enterprise.enterprise"
+	name := fmt.Sprintf("tf-enterprise-retain-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acc.TestAccPreCheck(t); testAccCheckIBMEnterprisePreCheck(t) },
+		ProviderFactories: acc.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIBMEnterpriseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnterpriseOnDeleteConfig(name, "retain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMEnterpriseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "on_delete", "retain"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMEnterpriseOnDeleteMoveToStandalone(t *testing.T) {
+	resourceName := "ibm_enterprise.enterprise"
+	name := fmt.Sprintf("tf-enterprise-standalone-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acc.TestAccPreCheck(t); testAccCheckIBMEnterprisePreCheck(t) },
+		ProviderFactories: acc.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIBMEnterpriseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnterpriseOnDeleteConfig(name, "move_to_standalone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMEnterpriseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "on_delete", "move_to_standalone"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccIBMEnterpriseOnDeleteFailIfChildren provisions an enterprise with no children, so
+// the destroy step is expected to succeed here too; the fail_if_children rejection and the
+// retain/move_to_standalone warnings are exercised without live child accounts by the unit
+// tests in resource_ibm_enterprise_delete_test.go.
+func TestAccIBMEnterpriseOnDeleteFailIfChildren(t *testing.T) {
+	resourceName := "ibm_enterprise.enterprise"
+	name := fmt.Sprintf("tf-enterprise-failchildren-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acc.TestAccPreCheck(t); testAccCheckIBMEnterprisePreCheck(t) },
+		ProviderFactories: acc.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckIBMEnterpriseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnterpriseOnDeleteConfig(name, "fail_if_children"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMEnterpriseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "on_delete", "fail_if_children"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnterpriseOnDeleteConfig(name, onDelete string) string {
+	return fmt.Sprintf(`
+		resource "ibm_enterprise" "enterprise" {
+			source_account_id      = "%s"
+			name                   = "%s"
+			primary_contact_iam_id = "%s"
+			on_delete              = "%s"
+		}
+	`, os.Getenv("IBM_ENTERPRISE_SOURCE_ACCOUNT_ID"), name, os.Getenv("IBM_ENTERPRISE_PRIMARY_CONTACT_IAM_ID"), onDelete)
+}
+
+func testAccCheckIBMEnterpriseExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no enterprise ID set for %s", resourceName)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMEnterpriseDestroy(s *terraform.State) error {
+	// The Enterprise Management API exposes no delete operation for an enterprise, so
+	// on_delete=retain/move_to_standalone destroys only ever remove the resource from
+	// Terraform state. All this check can confirm is that Terraform no longer tracks it.
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_enterprise" {
+			continue
+		}
+		if rs.Primary.ID != "" {
+			return fmt.Errorf("ibm_enterprise resource %s was not removed from state", rs.Primary.ID)
+		}
+	}
+	return nil
+}